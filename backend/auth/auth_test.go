@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-signing-secret"
+
+func signToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign test token: %v", err)
+	}
+	return signed
+}
+
+func newTestAuthenticator() *Authenticator {
+	return NewAuthenticator(Config{HS256Secret: []byte(testSecret), ClockSkew: 5 * time.Second})
+}
+
+func TestAuthenticate_ValidToken(t *testing.T) {
+	a := newTestAuthenticator()
+	token := signToken(t, jwt.MapClaims{
+		"sub":   "alice",
+		"scope": "users:read users:write",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+
+	principal, err := a.Authenticate("Bearer " + token)
+	if err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+	if principal.Subject != "alice" {
+		t.Errorf("expected subject alice, got %s", principal.Subject)
+	}
+	if !principal.HasScope(ScopeUsersRead) || !principal.HasScope(ScopeUsersWrite) {
+		t.Errorf("expected both scopes, got %+v", principal.Scopes)
+	}
+	if principal.HasScope(ScopeUsersAdmin) {
+		t.Errorf("did not expect admin scope, got %+v", principal.Scopes)
+	}
+}
+
+func TestAuthenticate_MissingToken(t *testing.T) {
+	a := newTestAuthenticator()
+
+	if _, err := a.Authenticate(""); err != ErrMissingToken {
+		t.Errorf("expected ErrMissingToken, got %v", err)
+	}
+	if _, err := a.Authenticate("not-a-bearer-header"); err != ErrMissingToken {
+		t.Errorf("expected ErrMissingToken, got %v", err)
+	}
+}
+
+func TestAuthenticate_ExpiredToken(t *testing.T) {
+	a := newTestAuthenticator()
+	token := signToken(t, jwt.MapClaims{
+		"sub": "alice",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	_, err := a.Authenticate("Bearer " + token)
+	var invalid *ErrInvalidToken
+	if err == nil {
+		t.Fatal("expected an error for an expired token")
+	}
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected ErrInvalidToken, got %T: %v", err, err)
+	}
+}
+
+func TestAuthenticate_BadSignature(t *testing.T) {
+	a := newTestAuthenticator()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "alice"})
+	signed, err := token.SignedString([]byte("wrong-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.Authenticate("Bearer " + signed); err == nil {
+		t.Error("expected an error for a badly-signed token")
+	}
+}
+
+func TestAuthenticate_ClockSkewTolerated(t *testing.T) {
+	a := NewAuthenticator(Config{HS256Secret: []byte(testSecret), ClockSkew: 30 * time.Second})
+	// exp is 10s in the past: within the 30s leeway, so still valid.
+	token := signToken(t, jwt.MapClaims{
+		"sub": "alice",
+		"exp": time.Now().Add(-10 * time.Second).Unix(),
+	})
+
+	if _, err := a.Authenticate("Bearer " + token); err != nil {
+		t.Errorf("expected token within clock skew to be accepted, got %v", err)
+	}
+}
+
+func TestAuthenticate_DevModeBypassesValidation(t *testing.T) {
+	a := NewAuthenticator(Config{Disabled: true})
+
+	principal, err := a.Authenticate("")
+	if err != nil {
+		t.Fatalf("expected dev mode to skip validation, got %v", err)
+	}
+	if !principal.HasScope(ScopeUsersAdmin) {
+		t.Errorf("expected dev mode principal to have all scopes, got %+v", principal.Scopes)
+	}
+}
+
+func TestPrincipal_RequireScope(t *testing.T) {
+	p := Principal{Subject: "alice", Scopes: []string{ScopeUsersRead}}
+
+	if err := p.RequireScope(ScopeUsersRead); err != nil {
+		t.Errorf("expected no error for a held scope, got %v", err)
+	}
+
+	err := p.RequireScope(ScopeUsersWrite)
+	var missing *ErrMissingScope
+	if !errors.As(err, &missing) {
+		t.Errorf("expected ErrMissingScope, got %v", err)
+	}
+}
+
+func TestAuthenticate_UnsupportedAlgorithm(t *testing.T) {
+	a := newTestAuthenticator()
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"sub": "alice"})
+	signed, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.Authenticate("Bearer " + signed); err == nil {
+		t.Error("expected the none algorithm to be rejected")
+	}
+}
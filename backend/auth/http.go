@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// httpError is the structured JSON error body returned for 401/403
+// responses, matching the shape step-ca/vault use for auth failures.
+type httpError struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// WriteError writes the JSON error response appropriate for err: 401 for a
+// missing or invalid token, 403 for a missing scope.
+func WriteError(w http.ResponseWriter, err error) {
+	var missingScope *ErrMissingScope
+	var invalidToken *ErrInvalidToken
+
+	status := http.StatusUnauthorized
+	code := "unauthorized"
+	switch {
+	case errors.As(err, &missingScope):
+		status = http.StatusForbidden
+		code = "forbidden"
+	case errors.Is(err, ErrMissingToken):
+		code = "missing_token"
+	case errors.As(err, &invalidToken):
+		code = "invalid_token"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(httpError{Error: err.Error(), Code: code})
+}
+
+// requestIDHeader is the header strato reads an inbound request ID from, and
+// echoes it back on so a client can correlate retries with audit entries.
+const requestIDHeader = "X-Request-Id"
+
+// RequestID returns r's inbound X-Request-Id, or generates a new one if the
+// caller didn't send one.
+func RequestID(r *http.Request) string {
+	if id := r.Header.Get(requestIDHeader); id != "" {
+		return id
+	}
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf[:])
+}
+
+// Audit records that subject performed action against resource, tagged with
+// requestID so the entry can be correlated with the request that triggered
+// it (e.g. in a downstream log aggregator).
+func Audit(requestID string, principal Principal, action, resource string) {
+	log.Printf("audit request_id=%s subject=%s action=%s resource=%s", requestID, principal.Subject, action, resource)
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func jwkFromRSAPublicKey(kid string, pub *rsa.PublicKey) jwk {
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big64(pub.E)),
+	}
+}
+
+func big64(e int) []byte {
+	// Minimal big-endian encoding of a small exponent (e.g. 65537), matching
+	// how real JWKS documents encode "e".
+	b := []byte{byte(e >> 16), byte(e >> 8), byte(e)}
+	i := 0
+	for i < len(b)-1 && b[i] == 0 {
+		i++
+	}
+	return b[i:]
+}
+
+func TestJWKSCache_FetchesAndCachesKey(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(jwkSet{Keys: []jwk{jwkFromRSAPublicKey("key-1", &priv.PublicKey)}})
+	}))
+	defer srv.Close()
+
+	cache := newJWKSCache(srv.URL)
+
+	got, err := cache.key("key-1")
+	if err != nil {
+		t.Fatalf("key() returned error: %v", err)
+	}
+	if got.N.Cmp(priv.PublicKey.N) != 0 || got.E != priv.PublicKey.E {
+		t.Errorf("fetched key does not match source key")
+	}
+
+	// A second lookup for the same kid should be served from cache.
+	if _, err := cache.key("key-1"); err != nil {
+		t.Fatalf("second key() call returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the JWKS endpoint to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestJWKSCache_UnknownKid(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jwkSet{Keys: []jwk{jwkFromRSAPublicKey("key-1", &priv.PublicKey)}})
+	}))
+	defer srv.Close()
+
+	cache := newJWKSCache(srv.URL)
+	if _, err := cache.key("missing-kid"); err == nil {
+		t.Error("expected an error for an unknown kid")
+	}
+}
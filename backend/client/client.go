@@ -0,0 +1,41 @@
+// Package client is a thin wrapper around strato's generated gRPC client,
+// for programs that want to talk to the UserService without depending on
+// the generated stubs directly.
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	stratov1 "github.com/devabhixda/strato/backend/proto/strato/v1"
+)
+
+// Client wraps a UserServiceClient and the connection backing it.
+type Client struct {
+	conn *grpc.ClientConn
+	stratov1.UserServiceClient
+}
+
+// Dial connects to a strato gRPC server at addr (e.g. "localhost:9090")
+// using an insecure connection, suitable for talking to the server over a
+// trusted network or during local development.
+func Dial(addr string) (*Client, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, UserServiceClient: stratov1.NewUserServiceClient(conn)}, nil
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// StreamUserChanges subscribes to the server's fan-out of user mutation
+// events and returns the resulting stream.
+func (c *Client) StreamUserChanges(ctx context.Context) (stratov1.UserService_StreamUserChangesClient, error) {
+	return c.UserServiceClient.StreamUserChanges(ctx, &stratov1.StreamUserChangesRequest{})
+}
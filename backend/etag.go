@@ -0,0 +1,19 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+// etagFor computes a strong ETag for a stored user row: the SHA-256 hash of
+// its persisted fields, hex-encoded and quoted per RFC 7232. Any field
+// change produces a different ETag, which is all optimistic concurrency on
+// PUT/PATCH/DELETE needs.
+func etagFor(u repository.User) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%t",
+		u.HumanUser, u.CreateDate, u.PasswordChangedDate, u.LastAccessDate, u.MFAEnabled)))
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
@@ -0,0 +1,73 @@
+// Package events implements a small in-process pub/sub broadcaster used to
+// fan mutation notifications out to gRPC streaming clients. It knows
+// nothing about HTTP or gRPC transports, only repository.User records.
+package events
+
+import (
+	"sync"
+
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+// Type identifies the kind of mutation an Event describes.
+type Type string
+
+const (
+	Created Type = "created"
+	Updated Type = "updated"
+	Deleted Type = "deleted"
+)
+
+// Event is published whenever a user is created, updated, or deleted.
+type Event struct {
+	Type Type
+	User repository.User
+}
+
+// Broadcaster fans Events out to any number of subscribers. The zero value
+// is not usable; construct one with NewBroadcaster.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+// NewBroadcaster returns an empty Broadcaster.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subs: make(map[chan Event]struct{})}
+}
+
+// Subscribe registers a new subscriber and returns a channel of future
+// Events along with a cancel func the caller must call when done listening
+// to release the channel.
+func (b *Broadcaster) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 16)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[ch]; ok {
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+	return ch, cancel
+}
+
+// Publish fans e out to every current subscriber. A subscriber whose
+// channel is full has the event dropped rather than blocking the
+// publisher; streaming clients should expect best-effort delivery.
+func (b *Broadcaster) Publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+func TestBroadcaster_PublishDeliversToSubscriber(t *testing.T) {
+	b := NewBroadcaster()
+	ch, cancel := b.Subscribe()
+	defer cancel()
+
+	want := Event{Type: Created, User: repository.User{HumanUser: "alice"}}
+	b.Publish(want)
+
+	select {
+	case got := <-ch:
+		if got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for published event")
+	}
+}
+
+func TestBroadcaster_CancelStopsDelivery(t *testing.T) {
+	b := NewBroadcaster()
+	ch, cancel := b.Subscribe()
+	cancel()
+
+	b.Publish(Event{Type: Deleted, User: repository.User{HumanUser: "bob"}})
+
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after cancel")
+	}
+}
+
+func TestBroadcaster_PublishWithNoSubscribersDoesNotBlock(t *testing.T) {
+	b := NewBroadcaster()
+	b.Publish(Event{Type: Updated, User: repository.User{HumanUser: "carol"}})
+}
@@ -0,0 +1,78 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/devabhixda/strato/backend/auth"
+	stratov1 "github.com/devabhixda/strato/backend/proto/strato/v1"
+)
+
+const testSecret = "test-signing-secret"
+
+func signToken(t *testing.T, scope string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "alice",
+		"scope": scope,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign test token: %v", err)
+	}
+	return signed
+}
+
+func newRealTestAuthenticator() *auth.Authenticator {
+	return auth.NewAuthenticator(auth.Config{HS256Secret: []byte(testSecret)})
+}
+
+func withAuthorization(ctx context.Context, value string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", value)
+}
+
+func TestInterceptors_RejectMissingToken(t *testing.T) {
+	client, _, _ := newTestClientWithAuth(t, newRealTestAuthenticator())
+
+	_, err := client.ListUsers(context.Background(), &stratov1.ListUsersRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestInterceptors_RejectMissingScope(t *testing.T) {
+	client, _, _ := newTestClientWithAuth(t, newRealTestAuthenticator())
+
+	ctx := withAuthorization(context.Background(), "Bearer "+signToken(t, auth.ScopeUsersRead))
+	if _, err := client.AddUser(ctx, &stratov1.AddUserRequest{HumanUser: "alice"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestInterceptors_AllowValidScope(t *testing.T) {
+	client, _, _ := newTestClientWithAuth(t, newRealTestAuthenticator())
+
+	ctx := withAuthorization(context.Background(), "Bearer "+signToken(t, auth.ScopeUsersRead))
+	if _, err := client.ListUsers(ctx, &stratov1.ListUsersRequest{}); err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+}
+
+func TestInterceptors_RejectMissingTokenOnStream(t *testing.T) {
+	client, _, _ := newTestClientWithAuth(t, newRealTestAuthenticator())
+
+	stream, err := client.StreamUserChanges(context.Background(), &stratov1.StreamUserChangesRequest{})
+	if err != nil {
+		t.Fatalf("StreamUserChanges returned error: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+// Package grpcserver implements strato's gRPC transport. It exposes the
+// same repository.UserRepository the HTTP API uses as a UserService, and
+// fans out mutation events to StreamUserChanges subscribers via
+// events.Broadcaster.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/devabhixda/strato/backend/events"
+	stratov1 "github.com/devabhixda/strato/backend/proto/strato/v1"
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+// Server implements stratov1.UserServiceServer.
+type Server struct {
+	stratov1.UnimplementedUserServiceServer
+
+	repo   repository.UserRepository
+	events *events.Broadcaster
+}
+
+// New returns a Server backed by repo, publishing mutation events to b.
+func New(repo repository.UserRepository, b *events.Broadcaster) *Server {
+	return &Server{repo: repo, events: b}
+}
+
+// toProtoUser converts a stored repository.User into its proto
+// representation, computing the days-since-* fields relative to now the
+// same way the HTTP API does.
+func toProtoUser(u repository.User, now time.Time) *stratov1.User {
+	out := &stratov1.User{
+		HumanUser:           u.HumanUser,
+		CreateDate:          u.CreateDate,
+		PasswordChangedDate: u.PasswordChangedDate,
+		LastAccessDate:      u.LastAccessDate,
+		MfaEnabled:          u.MFAEnabled,
+	}
+
+	if t, err := repository.ParseDate(u.PasswordChangedDate); err == nil {
+		out.DaysSinceLastPasswordChange = int32(now.Sub(t).Hours() / 24)
+	} else {
+		out.DaysSinceLastPasswordChange = -1
+	}
+
+	if t, err := repository.ParseDate(u.LastAccessDate); err == nil {
+		out.DaysSinceLastAccess = int32(now.Sub(t).Hours() / 24)
+	} else {
+		out.DaysSinceLastAccess = -1
+	}
+
+	return out
+}
+
+func (s *Server) ListUsers(ctx context.Context, req *stratov1.ListUsersRequest) (*stratov1.ListUsersResponse, error) {
+	stored, err := s.repo.List(ctx, repository.Filter{
+		Sort:   req.GetSort(),
+		Limit:  int(req.GetLimit()),
+		Cursor: req.GetCursor(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+	}
+
+	now := time.Now().UTC()
+	resp := &stratov1.ListUsersResponse{Users: make([]*stratov1.User, len(stored))}
+	for i, u := range stored {
+		resp.Users[i] = toProtoUser(u, now)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *stratov1.GetUserRequest) (*stratov1.GetUserResponse, error) {
+	stored, err := s.repo.Get(ctx, req.GetHumanUser())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.GetHumanUser())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get user: %v", err)
+	}
+	return &stratov1.GetUserResponse{User: toProtoUser(stored, time.Now().UTC())}, nil
+}
+
+func (s *Server) AddUser(ctx context.Context, req *stratov1.AddUserRequest) (*stratov1.AddUserResponse, error) {
+	if req.GetHumanUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "human_user is required")
+	}
+
+	input := repository.InputUser{
+		HumanUser:           req.GetHumanUser(),
+		CreateDate:          req.GetCreateDate(),
+		PasswordChangedDate: req.GetPasswordChangedDate(),
+		LastAccessDate:      req.GetLastAccessDate(),
+		MFAEnabled:          req.GetMfaEnabled(),
+	}
+	if err := s.repo.Create(ctx, input); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "user %q already exists", input.HumanUser)
+		}
+		return nil, status.Errorf(codes.Internal, "add user: %v", err)
+	}
+
+	stored := repository.FromInput(input)
+	s.events.Publish(events.Event{Type: events.Created, User: stored})
+	return &stratov1.AddUserResponse{User: toProtoUser(stored, time.Now().UTC())}, nil
+}
+
+func (s *Server) UpdateUser(ctx context.Context, req *stratov1.UpdateUserRequest) (*stratov1.UpdateUserResponse, error) {
+	if req.GetHumanUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "human_user is required")
+	}
+
+	input := repository.InputUser{
+		HumanUser:           req.GetHumanUser(),
+		CreateDate:          req.GetCreateDate(),
+		PasswordChangedDate: req.GetPasswordChangedDate(),
+		LastAccessDate:      req.GetLastAccessDate(),
+		MFAEnabled:          req.GetMfaEnabled(),
+	}
+	if err := s.repo.Update(ctx, req.GetHumanUser(), input); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", req.GetHumanUser())
+		}
+		return nil, status.Errorf(codes.Internal, "update user: %v", err)
+	}
+
+	stored := repository.FromInput(input)
+	s.events.Publish(events.Event{Type: events.Updated, User: stored})
+	return &stratov1.UpdateUserResponse{User: toProtoUser(stored, time.Now().UTC())}, nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, req *stratov1.DeleteUserRequest) (*stratov1.DeleteUserResponse, error) {
+	stored, err := s.repo.Get(ctx, req.GetHumanUser())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.GetHumanUser())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get user: %v", err)
+	}
+
+	if err := s.repo.Delete(ctx, req.GetHumanUser()); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete user: %v", err)
+	}
+
+	s.events.Publish(events.Event{Type: events.Deleted, User: stored})
+	return &stratov1.DeleteUserResponse{}, nil
+}
+
+func (s *Server) StreamUserChanges(_ *stratov1.StreamUserChangesRequest, stream stratov1.UserService_StreamUserChangesServer) error {
+	ch, cancel := s.events.Subscribe()
+	defer cancel()
+
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&stratov1.Event{Type: toProtoEventType(e.Type), User: toProtoUser(e.User, time.Now().UTC())}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func toProtoEventType(t events.Type) stratov1.Event_Type {
+	switch t {
+	case events.Created:
+		return stratov1.Event_CREATED
+	case events.Updated:
+		return stratov1.Event_UPDATED
+	case events.Deleted:
+		return stratov1.Event_DELETED
+	default:
+		return stratov1.Event_TYPE_UNSPECIFIED
+	}
+}
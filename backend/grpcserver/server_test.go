@@ -0,0 +1,148 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/devabhixda/strato/backend/auth"
+	"github.com/devabhixda/strato/backend/events"
+	stratov1 "github.com/devabhixda/strato/backend/proto/strato/v1"
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+// newTestClient spins up a Server over an in-memory bufconn listener, wired
+// through the same auth interceptors serveGRPC uses, and returns a client
+// connected to it along with the repository and broadcaster backing it.
+// Auth is disabled so these tests don't need to mint tokens; the
+// interceptors' enforcement is covered by TestInterceptors below and the
+// auth package's own tests.
+func newTestClient(t *testing.T, seed ...repository.User) (stratov1.UserServiceClient, repository.UserRepository, *events.Broadcaster) {
+	t.Helper()
+	return newTestClientWithAuth(t, auth.NewAuthenticator(auth.Config{Disabled: true}), seed...)
+}
+
+func newTestClientWithAuth(t *testing.T, authr *auth.Authenticator, seed ...repository.User) (stratov1.UserServiceClient, repository.UserRepository, *events.Broadcaster) {
+	t.Helper()
+
+	repo := repository.NewInMemRepositoryWithUsers(seed)
+	broadcaster := events.NewBroadcaster()
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryInterceptor(authr)),
+		grpc.StreamInterceptor(StreamInterceptor(authr)),
+	)
+	stratov1.RegisterUserServiceServer(grpcSrv, New(repo, broadcaster))
+	go grpcSrv.Serve(lis)
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return stratov1.NewUserServiceClient(conn), repo, broadcaster
+}
+
+func TestServer_AddGetListDeleteUser(t *testing.T) {
+	client, _, _ := newTestClient(t)
+	ctx := context.Background()
+
+	addResp, err := client.AddUser(ctx, &stratov1.AddUserRequest{
+		HumanUser:           "alice",
+		CreateDate:          "Jan 1 2024",
+		PasswordChangedDate: "Jan 1 2024",
+		LastAccessDate:      "Jan 1 2024",
+		MfaEnabled:          true,
+	})
+	if err != nil {
+		t.Fatalf("AddUser returned error: %v", err)
+	}
+	if addResp.GetUser().GetHumanUser() != "alice" {
+		t.Errorf("expected alice, got %q", addResp.GetUser().GetHumanUser())
+	}
+
+	getResp, err := client.GetUser(ctx, &stratov1.GetUserRequest{HumanUser: "alice"})
+	if err != nil {
+		t.Fatalf("GetUser returned error: %v", err)
+	}
+	if !getResp.GetUser().GetMfaEnabled() {
+		t.Error("expected MfaEnabled to be true")
+	}
+
+	listResp, err := client.ListUsers(ctx, &stratov1.ListUsersRequest{})
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if len(listResp.GetUsers()) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(listResp.GetUsers()))
+	}
+
+	if _, err := client.DeleteUser(ctx, &stratov1.DeleteUserRequest{HumanUser: "alice"}); err != nil {
+		t.Fatalf("DeleteUser returned error: %v", err)
+	}
+
+	if _, err := client.GetUser(ctx, &stratov1.GetUserRequest{HumanUser: "alice"}); err == nil {
+		t.Error("expected an error getting a deleted user")
+	}
+}
+
+func TestServer_AddUser_Conflict(t *testing.T) {
+	client, _, _ := newTestClient(t, repository.User{HumanUser: "alice"})
+
+	if _, err := client.AddUser(context.Background(), &stratov1.AddUserRequest{HumanUser: "alice"}); err == nil {
+		t.Error("expected an error adding a duplicate user")
+	}
+}
+
+func TestServer_StreamUserChanges_ReceivesMutationEvents(t *testing.T) {
+	client, _, _ := newTestClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.StreamUserChanges(ctx, &stratov1.StreamUserChangesRequest{})
+	if err != nil {
+		t.Fatalf("StreamUserChanges returned error: %v", err)
+	}
+
+	// The server subscribes to the broadcaster asynchronously as it starts
+	// handling the stream; give it a moment before publishing so the
+	// subscription is in place.
+	time.Sleep(100 * time.Millisecond)
+
+	if _, err := client.AddUser(context.Background(), &stratov1.AddUserRequest{HumanUser: "bob"}); err != nil {
+		t.Fatalf("AddUser returned error: %v", err)
+	}
+
+	recvCh := make(chan *stratov1.Event, 1)
+	go func() {
+		evt, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		recvCh <- evt
+	}()
+
+	select {
+	case evt := <-recvCh:
+		if evt.GetType() != stratov1.Event_CREATED {
+			t.Errorf("expected CREATED, got %v", evt.GetType())
+		}
+		if evt.GetUser().GetHumanUser() != "bob" {
+			t.Errorf("expected bob, got %q", evt.GetUser().GetHumanUser())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for streamed event")
+	}
+}
@@ -1,16 +1,29 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"flag"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq" // PostgreSQL driver
+	"google.golang.org/grpc"
+
+	"github.com/devabhixda/strato/backend/auth"
+	"github.com/devabhixda/strato/backend/events"
+	"github.com/devabhixda/strato/backend/grpcserver"
+	"github.com/devabhixda/strato/backend/migrations"
+	stratov1 "github.com/devabhixda/strato/backend/proto/strato/v1"
+	"github.com/devabhixda/strato/backend/repository"
 )
 
 // User struct to hold user data
@@ -33,199 +46,208 @@ type InputUser struct {
 	MFAEnabled          string `json:"mfaEnabled"`
 }
 
-var users []User
-var db *sql.DB // Global database connection
+// server holds the dependencies the HTTP handlers need. Handlers are methods
+// on server rather than free functions so tests can inject an in-memory
+// repository instead of talking to Postgres through sqlmock.
+type server struct {
+	repo   repository.UserRepository
+	auth   *auth.Authenticator
+	events *events.Broadcaster
+}
 
-func loadEnv() {
-	err := godotenv.Load() // Load .env file from the current directory
+// authenticate validates r's bearer token and checks it carries scope. On
+// success it returns the Principal and a request ID to tag audit log
+// entries with; on failure it has already written the error response and
+// the caller must return without doing anything else.
+func (s *server) authenticate(w http.ResponseWriter, r *http.Request, scope string) (auth.Principal, string, bool) {
+	principal, err := s.auth.Authenticate(r.Header.Get("Authorization"))
 	if err != nil {
-		log.Println("No .env file found, using default or environment-set variables")
+		auth.WriteError(w, err)
+		return auth.Principal{}, "", false
+	}
+	if err := principal.RequireScope(scope); err != nil {
+		auth.WriteError(w, err)
+		return auth.Principal{}, "", false
 	}
+	return principal, auth.RequestID(r), true
 }
 
-func connectDB() {
-	connStr := os.Getenv("DB_CONN_STR")
-	if connStr == "" {
-		log.Fatal("DB_CONN_STR environment variable not set")
+// toAPIUser converts a stored repository.User into the wire representation,
+// where MFAEnabled is rendered as "Yes"/"No" for backwards compatibility.
+func toAPIUser(u repository.User) User {
+	mfa := "No"
+	if u.MFAEnabled {
+		mfa = "Yes"
 	}
-	var err error
-
-	// Only open a new connection if db is nil (i.e., not already set by a mock or previous call)
-	if db == nil {
-		log.Println("Global db is nil. Attempting to open and ping new database connection.") // Diagnostic log
-		db, err = sql.Open("postgres", connStr)
-		if err != nil {
-			log.Fatalf("Failed to connect to database: %v", err)
-		}
+	return User{
+		HumanUser:           u.HumanUser,
+		CreateDate:          u.CreateDate,
+		PasswordChangedDate: u.PasswordChangedDate,
+		LastAccessDate:      u.LastAccessDate,
+		MFAEnabled:          mfa,
+	}
+}
 
-		err = db.Ping() // Ping the newly opened connection
-		if err != nil {
-			// This is where your test is currently failing because it's a real ping
-			log.Fatalf("Failed to ping database: %v", err)
-		}
-		log.Println("Database connection established and pinged successfully.")
-	} else {
-		log.Println("Global db is already set. Using existing connection (mock in tests).") // Diagnostic log
+// toRepoInput converts an InputUser from the wire into a repository.InputUser,
+// rejecting anything other than "Yes"/"No" for MFAEnabled.
+func toRepoInput(u InputUser) (repository.InputUser, error) {
+	var mfaEnabled bool
+	switch strings.ToLower(u.MFAEnabled) {
+	case "yes":
+		mfaEnabled = true
+	case "no":
+		mfaEnabled = false
+	default:
+		return repository.InputUser{}, errInvalidMFAEnabled
 	}
+	return repository.InputUser{
+		HumanUser:           u.HumanUser,
+		CreateDate:          u.CreateDate,
+		PasswordChangedDate: u.PasswordChangedDate,
+		LastAccessDate:      u.LastAccessDate,
+		MFAEnabled:          mfaEnabled,
+	}, nil
 }
 
-func initializeDB() {
-	log.Println("Initializing database...")
+var errInvalidMFAEnabled = errValidation("MFAEnabled must be 'Yes' or 'No'")
 
-	// Check if table exists first
-	var exists bool
-	err := db.QueryRow("SELECT EXISTS (SELECT FROM information_schema.tables WHERE table_name = 'users_table')").Scan(&exists)
-	if err != nil {
-		log.Fatalf("Failed to check if users_table exists: %v", err)
-	}
+type errValidation string
 
-	if !exists {
-		log.Println("Creating users_table...")
-		_, err = db.Exec(`
-            CREATE TABLE users_table (
-                human_user VARCHAR(255) PRIMARY KEY,
-                create_date VARCHAR(255),
-                password_changed_date VARCHAR(255),
-                last_access_date VARCHAR(255),
-                mfa_enabled BOOLEAN
-            )
-        `)
-		if err != nil {
-			log.Fatalf("Failed to create users_table: %v", err)
-		}
+func (e errValidation) Error() string { return string(e) }
 
-		// Seed initial data
-		log.Println("Seeding initial user data...")
-		_, err = db.Exec(`
-            INSERT INTO users_table (human_user, create_date, password_changed_date, last_access_date, mfa_enabled) VALUES
-            ('Foo Bar1', 'Oct 1 2020', 'Oct 1 2021', 'Jan 4 2025', 'true'),
-			('Foo1 Bar1', 'Sep 20 2019', 'Sep 22 2019', 'Feb 8 2025', 'false'),
-			('Foo2 Bar2', 'Feb 3 2022', 'Feb 3 2022', 'Feb 12 2025', 'false'),
-			('Foo3 Bar3', 'Mar 7 2023', 'Mar 10 2023', 'Jan 3 2022', 'true'),
-			('Foo Bar4', 'Apr 8 2018', 'Apr 12 2020', 'Oct 4 2022', 'false')
-        `)
-		if err != nil {
-			log.Fatalf("Failed to seed initial data: %v", err)
-		}
-		log.Println("Database initialization complete.")
-	} else {
-		log.Println("users_table already exists, skipping initialization.")
+func loadEnv() {
+	err := godotenv.Load() // Load .env file from the current directory
+	if err != nil {
+		log.Println("No .env file found, using default or environment-set variables")
 	}
 }
 
-func loadUsers() {
+func connectDB() *sql.DB {
+	connStr := os.Getenv("DB_CONN_STR")
+	if connStr == "" {
+		log.Fatal("DB_CONN_STR environment variable not set")
+	}
 
-	// The rest of the function uses the 'db' instance (either real or mock)
-	rows, err := db.Query("SELECT human_user, create_date, password_changed_date, last_access_date, mfa_enabled FROM users_table")
+	db, err := sql.Open("postgres", connStr)
 	if err != nil {
-		log.Fatalf("Failed to query users from database: %v", err)
+		log.Fatalf("Failed to connect to database: %v", err)
 	}
-	defer rows.Close()
-
-	var loadedUsers []User
-	for rows.Next() {
-		var u User
-		var createDate, passwordChangedDate, lastAccessDate sql.NullString
-		var mfaEnabledDB sql.NullBool // Use sql.NullBool for boolean from DB
-
-		err := rows.Scan(&u.HumanUser, &createDate, &passwordChangedDate, &lastAccessDate, &mfaEnabledDB)
-		if err != nil {
-			log.Printf("Failed to scan user row: %v", err)
-			continue // Skip this user
-		}
-		// Handle nullable date strings
-		if createDate.Valid {
-			u.CreateDate = createDate.String
-		}
-		if passwordChangedDate.Valid {
-			u.PasswordChangedDate = passwordChangedDate.String
-		}
-		if lastAccessDate.Valid {
-			u.LastAccessDate = lastAccessDate.String
-		}
-
-		// Convert boolean mfaEnabledDB to "Yes"/"No" string
-		if mfaEnabledDB.Valid {
-			if mfaEnabledDB.Bool {
-				u.MFAEnabled = "Yes"
-			} else {
-				u.MFAEnabled = "No"
-			}
-		} else {
-			u.MFAEnabled = "No" // Default for NULL MFA status, or "Unknown"
-		}
-
-		loadedUsers = append(loadedUsers, u)
+	if err := db.Ping(); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
 	}
-	if err = rows.Err(); err != nil {
-		log.Fatalf("Error iterating user rows: %v", err)
+	log.Println("Database connection established and pinged successfully.")
+	return db
+}
+
+// initializeDB brings the database schema up to date by applying any
+// pending migrations under migrations/. See the migrations package for how
+// migrations are tracked and why this is safe to call on every startup.
+func initializeDB(db *sql.DB) {
+	log.Println("Applying database migrations...")
+	if err := migrations.Run(context.Background(), db); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
 	}
-	users = loadedUsers
-	log.Printf("Successfully loaded %d users from database", len(users))
+	log.Println("Database migrations up to date.")
 }
 
-func usersHandler(w http.ResponseWriter, r *http.Request) {
+func (s *server) usersHandler(w http.ResponseWriter, r *http.Request) {
 	// Set CORS headers
 	w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
 	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 	if r.Method == "OPTIONS" {
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
-	if r.Method == http.MethodGet {
-		getUsers(w, r)
-	} else if r.Method == http.MethodPost {
-		addUser(w, r)
-	} else {
+	switch r.Method {
+	case http.MethodGet:
+		principal, reqID, ok := s.authenticate(w, r, auth.ScopeUsersRead)
+		if !ok {
+			return
+		}
+		s.getUsers(w, r, principal, reqID)
+	case http.MethodPost:
+		principal, reqID, ok := s.authenticate(w, r, auth.ScopeUsersWrite)
+		if !ok {
+			return
+		}
+		s.addUser(w, r, principal, reqID)
+	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
-func getUsers(w http.ResponseWriter, r *http.Request) {
-	responseUsers := make([]User, len(users))
-	copy(responseUsers, users) // Work on a copy to ensure calculations are fresh per request
-
-	now := time.Now().UTC()
-	dateFormat := "Jan 2 2006"
-	for i := range responseUsers {
-		currentUser := &responseUsers[i]
-		// Calculate DaysSinceLastPasswordChange
-		if currentUser.PasswordChangedDate != "" {
-			pwdChangedDate, err := time.Parse(dateFormat, currentUser.PasswordChangedDate)
-			if err != nil {
-				log.Printf("Error parsing PasswordChangedDate ('%s') for user %s: %v", currentUser.PasswordChangedDate, currentUser.HumanUser, err)
-				currentUser.DaysSinceLastPasswordChange = -1 // Indicate error
-			} else {
-				duration := now.Sub(pwdChangedDate)
-				currentUser.DaysSinceLastPasswordChange = int(duration.Hours() / 24)
-			}
+// withComputedFields returns a copy of u with DaysSinceLastPasswordChange and
+// DaysSinceLastAccess recomputed relative to now, so every caller (the users
+// list, the compliance report, ...) derives the same staleness numbers.
+// Dates are parsed via repository.ParseDate, which accepts the current
+// RFC3339 wire format and falls back to the legacy "Jan 2 2006" layout.
+func withComputedFields(u User, now time.Time) User {
+	// Calculate DaysSinceLastPasswordChange
+	if u.PasswordChangedDate != "" {
+		pwdChangedDate, err := repository.ParseDate(u.PasswordChangedDate)
+		if err != nil {
+			log.Printf("Error parsing PasswordChangedDate ('%s') for user %s: %v", u.PasswordChangedDate, u.HumanUser, err)
+			u.DaysSinceLastPasswordChange = -1 // Indicate error
 		} else {
-			currentUser.DaysSinceLastPasswordChange = -1 // Indicate missing date
+			duration := now.Sub(pwdChangedDate)
+			u.DaysSinceLastPasswordChange = int(duration.Hours() / 24)
 		}
+	} else {
+		u.DaysSinceLastPasswordChange = -1 // Indicate missing date
+	}
 
-		// Calculate DaysSinceLastAccess
-		if currentUser.LastAccessDate != "" {
-			lastAccess, err := time.Parse(dateFormat, currentUser.LastAccessDate)
-			if err != nil {
-				log.Printf("Error parsing LastAccessDate ('%s') for user %s: %v", currentUser.LastAccessDate, currentUser.HumanUser, err)
-				currentUser.DaysSinceLastAccess = -1 // Indicate error
-			} else {
-				duration := now.Sub(lastAccess)
-				currentUser.DaysSinceLastAccess = int(duration.Hours() / 24)
-			}
+	// Calculate DaysSinceLastAccess
+	if u.LastAccessDate != "" {
+		lastAccess, err := repository.ParseDate(u.LastAccessDate)
+		if err != nil {
+			log.Printf("Error parsing LastAccessDate ('%s') for user %s: %v", u.LastAccessDate, u.HumanUser, err)
+			u.DaysSinceLastAccess = -1 // Indicate error
 		} else {
-			currentUser.DaysSinceLastAccess = -1 // Indicate missing date
+			duration := now.Sub(lastAccess)
+			u.DaysSinceLastAccess = int(duration.Hours() / 24)
 		}
+	} else {
+		u.DaysSinceLastAccess = -1 // Indicate missing date
+	}
+
+	return u
+}
+
+// parseListFilter reads limit/cursor/sort query params for the paginated
+// collection GET.
+func parseListFilter(r *http.Request) repository.Filter {
+	var f repository.Filter
+	f.Sort = r.URL.Query().Get("sort")
+	f.Cursor = r.URL.Query().Get("cursor")
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 {
+		f.Limit = limit
+	}
+	return f
+}
+
+func (s *server) getUsers(w http.ResponseWriter, r *http.Request, principal auth.Principal, reqID string) {
+	stored, err := s.repo.List(r.Context(), parseListFilter(r))
+	if err != nil {
+		log.Printf("Failed to list users: %v", err)
+		http.Error(w, "Failed to list users", http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now().UTC()
+	responseUsers := make([]User, len(stored))
+	for i, u := range stored {
+		responseUsers[i] = withComputedFields(toAPIUser(u), now)
 	}
 
+	auth.Audit(reqID, principal, "list", "users")
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(responseUsers)
 }
 
-func addUser(w http.ResponseWriter, r *http.Request) {
+func (s *server) addUser(w http.ResponseWriter, r *http.Request, principal auth.Principal, reqID string) {
 	var newUser InputUser
 	err := json.NewDecoder(r.Body).Decode(&newUser)
 	if err != nil {
@@ -238,46 +260,51 @@ func addUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var mfaEnabledDB bool
-	switch strings.ToLower(newUser.MFAEnabled) {
-	case "yes":
-		mfaEnabledDB = true
-	case "no":
-		mfaEnabledDB = false
-	default:
-		http.Error(w, "MFAEnabled must be 'Yes' or 'No'", http.StatusBadRequest)
-		return
-	}
-
-	stmt, err := db.Prepare("INSERT INTO users_table (human_user, create_date, password_changed_date, last_access_date, mfa_enabled) VALUES ($1, $2, $3, $4, $5)")
+	input, err := toRepoInput(newUser)
 	if err != nil {
-		log.Printf("Error preparing statement for add user: %v", err)
-		http.Error(w, "Failed to add user", http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	defer stmt.Close()
 
-	_, err = stmt.Exec(newUser.HumanUser, newUser.CreateDate, newUser.PasswordChangedDate, newUser.LastAccessDate, mfaEnabledDB)
-	if err != nil {
-		log.Printf("Error executing statement for add user: %v", err)
+	if err := s.repo.Create(r.Context(), input); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			http.Error(w, "User already exists", http.StatusConflict)
+			return
+		}
+		log.Printf("Error creating user: %v", err)
 		http.Error(w, "Failed to add user to database", http.StatusInternalServerError)
 		return
 	}
 
-	loadUsers()
-
+	auth.Audit(reqID, principal, "create", newUser.HumanUser)
+	s.events.Publish(events.Event{Type: events.Created, User: repository.FromInput(input)})
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]string{"message": "User added successfully"})
 	log.Printf("User %s added successfully", newUser.HumanUser)
 }
 
 func main() {
+	authMode := flag.String("auth", "jwt", "authentication mode: 'jwt' (default) or 'none' for local dev")
+	flag.Parse()
+
 	loadEnv()
-	connectDB()
-	initializeDB()
-	loadUsers()
+	db := connectDB()
+	initializeDB(db)
+
+	repo := repository.NewPostgresRepository(db)
+	broadcaster := events.NewBroadcaster()
 
-	http.HandleFunc("/api/users", usersHandler)
+	s := &server{
+		repo:   repo,
+		auth:   auth.NewAuthenticator(auth.ConfigFromEnv(*authMode == "none")),
+		events: broadcaster,
+	}
+
+	http.HandleFunc("/api/users", s.usersHandler)
+	http.HandleFunc("/api/users/report", s.reportHandler)
+	http.HandleFunc("/api/users/", s.userHandler)
+
+	go serveGRPC(repo, broadcaster, s.auth)
 
 	port := "8080"
 	log.Printf("Server starting on port %s\n", port)
@@ -286,3 +313,30 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// serveGRPC runs strato's gRPC transport alongside the HTTP API, sharing
+// repo and broadcaster with it. It listens on GRPC_PORT, defaulting to 9090.
+// Every RPC goes through the same JWT bearer auth, RBAC scopes, and audit
+// log hook as its HTTP counterpart, via grpcserver's interceptors.
+func serveGRPC(repo repository.UserRepository, broadcaster *events.Broadcaster, authr *auth.Authenticator) {
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+
+	lis, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		log.Fatalf("Failed to listen on gRPC port %s: %v", grpcPort, err)
+	}
+
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcserver.UnaryInterceptor(authr)),
+		grpc.StreamInterceptor(grpcserver.StreamInterceptor(authr)),
+	)
+	stratov1.RegisterUserServiceServer(grpcSrv, grpcserver.New(repo, broadcaster))
+
+	log.Printf("gRPC server starting on port %s\n", grpcPort)
+	if err := grpcSrv.Serve(lis); err != nil {
+		log.Fatalf("gRPC server failed: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+// Package migrations applies strato's versioned SQL migrations (embedded
+// from this package's directory) to a Postgres database, tracking what has
+// run in a schema_migrations table keyed by version and guarded by a
+// checksum of each file's contents. It replaces the ad-hoc
+// create-if-not-exists logic main.go used to run at startup, so future
+// schema changes (e.g. adding risk_score, email, groups) ship as new
+// numbered files instead of edits to existing ones.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+type migration struct {
+	version  int
+	name     string
+	checksum string
+	sql      string
+}
+
+// Run applies every migration embedded in this package that hasn't already
+// been recorded in schema_migrations, in version order, each in its own
+// transaction. It is safe to call on every startup: already-applied
+// migrations are skipped, and a migration whose on-disk contents changed
+// since it was applied returns an error rather than silently re-running.
+func Run(ctx context.Context, db *sql.DB) error {
+	if err := ensureSchemaMigrationsTable(ctx, db); err != nil {
+		return fmt.Errorf("migrate: creating schema_migrations: %w", err)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return fmt.Errorf("migrate: loading migrations: %w", err)
+	}
+
+	applied, err := appliedChecksums(ctx, db)
+	if err != nil {
+		return fmt.Errorf("migrate: reading schema_migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if checksum, ok := applied[m.version]; ok {
+			if checksum != m.checksum {
+				return fmt.Errorf("migrate: migration %04d_%s has changed since it was applied", m.version, m.name)
+			}
+			continue
+		}
+
+		if err := apply(ctx, db, m); err != nil {
+			return fmt.Errorf("migrate: applying %04d_%s: %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+func ensureSchemaMigrationsTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			name       TEXT NOT NULL,
+			checksum   TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)
+	`)
+	return err
+}
+
+// loadMigrations reads every embedded *.sql file, parses its version and
+// name from the filename, and returns them sorted by version.
+func loadMigrations() ([]migration, error) {
+	entries, err := files.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]migration, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		version, name, err := parseFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		contents, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(contents)
+		migrations = append(migrations, migration{
+			version:  version,
+			name:     name,
+			checksum: fmt.Sprintf("%x", sum),
+			sql:      string(contents),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// parseFilename splits a migration filename of the form "0001_name.sql"
+// into its version and name.
+func parseFilename(filename string) (version int, name string, err error) {
+	trimmed := strings.TrimSuffix(filename, ".sql")
+	parts := strings.SplitN(trimmed, "_", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("migrate: invalid migration filename %q, want NNNN_name.sql", filename)
+	}
+
+	version, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("migrate: invalid migration version in filename %q: %w", filename, err)
+	}
+	return version, parts[1], nil
+}
+
+func appliedChecksums(ctx context.Context, db *sql.DB) (map[int]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version, checksum FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]string)
+	for rows.Next() {
+		var version int
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, err
+		}
+		applied[version] = checksum
+	}
+	return applied, rows.Err()
+}
+
+func apply(ctx context.Context, db *sql.DB, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, m.sql); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+		m.version, m.name, m.checksum); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,114 @@
+package migrations
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRun_AppliesPendingMigrations(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open mock db: %v", err)
+	}
+	defer mockDB.Close()
+
+	all, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations returned error: %v", err)
+	}
+	if len(all) == 0 {
+		t.Fatal("expected at least one embedded migration")
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, checksum FROM schema_migrations")).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "checksum"}))
+
+	for range all {
+		mock.ExpectBegin()
+		mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations")).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+	}
+
+	if err := Run(context.Background(), mockDB); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRun_SkipsAlreadyAppliedMigrations(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open mock db: %v", err)
+	}
+	defer mockDB.Close()
+
+	all, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations returned error: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"version", "checksum"})
+	for _, m := range all {
+		rows.AddRow(m.version, m.checksum)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, checksum FROM schema_migrations")).WillReturnRows(rows)
+
+	// Running again should not apply anything: no Begin/Exec/Commit
+	// expectations are set up for the migration bodies themselves.
+	if err := Run(context.Background(), mockDB); err != nil {
+		t.Fatalf("second Run returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRun_ErrorsWhenAppliedMigrationChanged(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open mock db: %v", err)
+	}
+	defer mockDB.Close()
+
+	all, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations returned error: %v", err)
+	}
+	if len(all) == 0 {
+		t.Fatal("expected at least one embedded migration")
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, checksum FROM schema_migrations")).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "checksum"}).AddRow(all[0].version, "stale-checksum"))
+
+	if err := Run(context.Background(), mockDB); err == nil {
+		t.Error("expected an error when an applied migration's checksum no longer matches")
+	}
+}
+
+func TestParseFilename(t *testing.T) {
+	version, name, err := parseFilename("0001_create_users_table.sql")
+	if err != nil {
+		t.Fatalf("parseFilename returned error: %v", err)
+	}
+	if version != 1 || name != "create_users_table" {
+		t.Errorf("got version=%d name=%q, want version=1 name=%q", version, name, "create_users_table")
+	}
+
+	if _, _, err := parseFilename("notaversion.sql"); err == nil {
+		t.Error("expected an error for a filename without a version prefix")
+	}
+}
+
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+func TestComputeRiskScore_Boundaries(t *testing.T) {
+	w := riskWeights{PasswordAge: 1, AccessAge: 1, NoMFA: 1}
+
+	cases := []struct {
+		name  string
+		user  User
+		score int
+	}{
+		{"all healthy", User{DaysSinceLastPasswordChange: 90, DaysSinceLastAccess: 30, MFAEnabled: "Yes"}, 0},
+		{"stale password only", User{DaysSinceLastPasswordChange: 100, DaysSinceLastAccess: 30, MFAEnabled: "Yes"}, 10},
+		{"stale access only", User{DaysSinceLastPasswordChange: 90, DaysSinceLastAccess: 45, MFAEnabled: "Yes"}, 15},
+		{"no mfa only", User{DaysSinceLastPasswordChange: 90, DaysSinceLastAccess: 30, MFAEnabled: "No"}, 50},
+		{"clamped at 100", User{DaysSinceLastPasswordChange: 500, DaysSinceLastAccess: 500, MFAEnabled: "No"}, 100},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := computeRiskScore(c.user, w)
+			if got != c.score {
+				t.Errorf("computeRiskScore(%+v) = %d, want %d", c.user, got, c.score)
+			}
+		})
+	}
+}
+
+func TestClassifyRisk(t *testing.T) {
+	thresholds := riskThresholds{Medium: 25, High: 50, Critical: 75}
+
+	cases := []struct {
+		score int
+		level string
+	}{
+		{0, RiskLow},
+		{24, RiskLow},
+		{25, RiskMedium},
+		{49, RiskMedium},
+		{50, RiskHigh},
+		{74, RiskHigh},
+		{75, RiskCritical},
+		{100, RiskCritical},
+	}
+
+	for _, c := range cases {
+		if got := classifyRisk(c.score, thresholds); got != c.level {
+			t.Errorf("classifyRisk(%d) = %s, want %s", c.score, got, c.level)
+		}
+	}
+}
+
+func TestReportHandler_JSON(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "stale-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: false},
+		repository.User{HumanUser: "healthy-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: true},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users/report", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+
+	var rows []UserRiskReport
+	if err := json.Unmarshal(rr.Body.Bytes(), &rows); err != nil {
+		t.Fatalf("could not unmarshal response: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+}
+
+func TestReportHandler_FilterByRiskAndMFA(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "stale-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: false},
+		repository.User{HumanUser: "healthy-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: true},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users/report?risk=critical&mfa=false", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	var rows []UserRiskReport
+	if err := json.Unmarshal(rr.Body.Bytes(), &rows); err != nil {
+		t.Fatalf("could not unmarshal response: %v", err)
+	}
+	if len(rows) != 1 || rows[0].HumanUser != "stale-user" {
+		t.Errorf("expected only stale-user, got %+v", rows)
+	}
+}
+
+func TestReportHandler_StaleDaysFilter(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "stale-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: false},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users/report?stale_days=100000", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	var rows []UserRiskReport
+	json.Unmarshal(rr.Body.Bytes(), &rows)
+	if len(rows) != 0 {
+		t.Errorf("expected no rows to pass an unreachable stale_days filter, got %d", len(rows))
+	}
+}
+
+func TestReportHandler_InvalidFilter(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("GET", "/api/users/report?risk=nope", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid risk filter, got %d", rr.Code)
+	}
+}
+
+func TestReportHandler_CSVFormat(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "csv-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: true},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users/report?format=csv", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if ct := rr.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Errorf("expected text/csv content type, got %s", ct)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(rr.Body.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("could not parse CSV response: %v", err)
+	}
+	if len(records) != 2 || records[1][0] != "csv-user" {
+		t.Errorf("unexpected CSV rows: %+v", records)
+	}
+}
+
+func TestReportHandler_AWSCSVFormat(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "aws-user", PasswordChangedDate: "Jan 1 2020", LastAccessDate: "Jan 1 2020", MFAEnabled: true},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users/report?format=aws-csv", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	records, err := csv.NewReader(strings.NewReader(rr.Body.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("could not parse CSV response: %v", err)
+	}
+	want := []string{"user", "password_last_changed", "password_last_used", "mfa_active"}
+	for i, col := range want {
+		if records[0][i] != col {
+			t.Errorf("expected AWS-style column %q at index %d, got %q", col, i, records[0][i])
+		}
+	}
+	if records[1][3] != "true" {
+		t.Errorf("expected mfa_active=true for aws-user, got %s", records[1][3])
+	}
+}
+
+func TestReportHandler_UnknownFormat(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("GET", "/api/users/report?format=xml", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for unknown format, got %d", rr.Code)
+	}
+}
+
+func TestReportHandler_OptionsMethod(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("OPTIONS", "/api/users/report", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("OPTIONS request returned wrong status code: got %d want %d", rr.Code, http.StatusOK)
+	}
+}
+
+func TestReportHandler_InvalidMethod(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("DELETE", "/api/users/report", nil)
+	rr := httptest.NewRecorder()
+	s.reportHandler(rr, req)
+
+	if rr.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rr.Code)
+	}
+}
+
+func TestEnvFloatAndEnvInt_Fallback(t *testing.T) {
+	os.Unsetenv("REPORT_WEIGHT_PASSWORD_AGE_TEST")
+	if got := envFloat("REPORT_WEIGHT_PASSWORD_AGE_TEST", 1.5); got != 1.5 {
+		t.Errorf("expected fallback 1.5, got %v", got)
+	}
+	os.Unsetenv("REPORT_THRESHOLD_MEDIUM_TEST")
+	if got := envInt("REPORT_THRESHOLD_MEDIUM_TEST", 25); got != 25 {
+		t.Errorf("expected fallback 25, got %v", got)
+	}
+}
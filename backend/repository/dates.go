@@ -0,0 +1,22 @@
+package repository
+
+import "time"
+
+// LegacyDateFormat is the date layout strato used on the wire and in
+// Postgres before dates moved to RFC3339/TIMESTAMPTZ. ParseDate still
+// accepts it for one release so existing clients don't break mid-migration.
+const LegacyDateFormat = "Jan 2 2006"
+
+// ParseDate parses s as RFC3339, the current wire and storage format,
+// falling back to LegacyDateFormat for callers that haven't migrated yet.
+func ParseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(LegacyDateFormat, s)
+}
+
+// FormatDate renders t in the current wire/storage format (RFC3339).
+func FormatDate(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
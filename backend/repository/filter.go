@@ -0,0 +1,31 @@
+package repository
+
+import "sort"
+
+// applyFilter sorts users per f.Sort (ties broken on HumanUser), skips past
+// f.Cursor, and caps the result at f.Limit. Shared by every UserRepository
+// implementation so pagination behaves identically regardless of store.
+func applyFilter(users []User, f Filter) []User {
+	sort.Slice(users, func(i, j int) bool {
+		a, b := users[i], users[j]
+		if f.Sort == "lastAccessDate" && a.LastAccessDate != b.LastAccessDate {
+			return a.LastAccessDate < b.LastAccessDate
+		}
+		return a.HumanUser < b.HumanUser
+	})
+
+	if f.Cursor != "" {
+		for i, u := range users {
+			if u.HumanUser == f.Cursor {
+				users = users[i+1:]
+				break
+			}
+		}
+	}
+
+	if f.Limit > 0 && len(users) > f.Limit {
+		users = users[:f.Limit]
+	}
+
+	return users
+}
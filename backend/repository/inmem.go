@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// inMemRepo is a UserRepository backed by a map held in process memory. It
+// is used in tests and local dev in place of Postgres.
+type inMemRepo struct {
+	mu    sync.RWMutex
+	users map[string]User
+}
+
+// NewInMemRepository returns an empty in-memory UserRepository.
+func NewInMemRepository() UserRepository {
+	return &inMemRepo{users: make(map[string]User)}
+}
+
+// NewInMemRepositoryWithUsers returns an in-memory UserRepository seeded
+// with the given users, keyed by HumanUser.
+func NewInMemRepositoryWithUsers(seed []User) UserRepository {
+	r := &inMemRepo{users: make(map[string]User, len(seed))}
+	for _, u := range seed {
+		r.users[u.HumanUser] = u
+	}
+	return r
+}
+
+func (r *inMemRepo) List(_ context.Context, f Filter) ([]User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]User, 0, len(r.users))
+	for _, u := range r.users {
+		out = append(out, u)
+	}
+	return applyFilter(out, f), nil
+}
+
+func (r *inMemRepo) Get(_ context.Context, humanUser string) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[humanUser]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	return u, nil
+}
+
+func (r *inMemRepo) Create(_ context.Context, input InputUser) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[input.HumanUser]; exists {
+		return ErrAlreadyExists
+	}
+	u := FromInput(input)
+	u.ID = uuid.NewString()
+	r.users[input.HumanUser] = u
+	return nil
+}
+
+func (r *inMemRepo) Update(_ context.Context, humanUser string, input InputUser) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.users[humanUser]
+	if !exists {
+		return ErrNotFound
+	}
+	updated := FromInput(input)
+	updated.ID = existing.ID
+	updated.HumanUser = humanUser
+	r.users[humanUser] = updated
+	return nil
+}
+
+func (r *inMemRepo) Delete(_ context.Context, humanUser string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[humanUser]; !exists {
+		return ErrNotFound
+	}
+	delete(r.users, humanUser)
+	return nil
+}
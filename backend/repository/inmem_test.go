@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInMemRepository_CreateListGet(t *testing.T) {
+	repo := NewInMemRepository()
+	ctx := context.Background()
+
+	err := repo.Create(ctx, InputUser{HumanUser: "alice", MFAEnabled: true})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	got, err := repo.Get(ctx, "alice")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got.HumanUser != "alice" || !got.MFAEnabled {
+		t.Errorf("unexpected user: %+v", got)
+	}
+
+	list, err := repo.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("expected 1 user, got %d", len(list))
+	}
+}
+
+func TestInMemRepository_CreateDuplicate(t *testing.T) {
+	repo := NewInMemRepositoryWithUsers([]User{{HumanUser: "alice"}})
+
+	err := repo.Create(context.Background(), InputUser{HumanUser: "alice"})
+	if err != ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists, got %v", err)
+	}
+}
+
+func TestInMemRepository_GetNotFound(t *testing.T) {
+	repo := NewInMemRepository()
+
+	_, err := repo.Get(context.Background(), "missing")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestInMemRepository_UpdateAndDelete(t *testing.T) {
+	repo := NewInMemRepositoryWithUsers([]User{{HumanUser: "bob", MFAEnabled: false}})
+	ctx := context.Background()
+
+	err := repo.Update(ctx, "bob", InputUser{HumanUser: "bob", MFAEnabled: true})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	got, _ := repo.Get(ctx, "bob")
+	if !got.MFAEnabled {
+		t.Errorf("expected MFAEnabled true after update, got %+v", got)
+	}
+
+	if err := repo.Delete(ctx, "bob"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if _, err := repo.Get(ctx, "bob"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestInMemRepository_UpdateDeleteMissing(t *testing.T) {
+	repo := NewInMemRepository()
+	ctx := context.Background()
+
+	if err := repo.Update(ctx, "ghost", InputUser{}); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound from Update, got %v", err)
+	}
+	if err := repo.Delete(ctx, "ghost"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound from Delete, got %v", err)
+	}
+}
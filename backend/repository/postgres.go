@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+// postgresRepo is a UserRepository backed by the users_table in Postgres.
+// human_user identifies a row to callers, but id (a UUID) is the table's
+// true primary key; see migrations/0001_create_users_table.sql.
+type postgresRepo struct {
+	db *sql.DB
+}
+
+// NewPostgresRepository returns a UserRepository that reads and writes
+// through db.
+func NewPostgresRepository(db *sql.DB) UserRepository {
+	return &postgresRepo{db: db}
+}
+
+func (r *postgresRepo) List(ctx context.Context, f Filter) ([]User, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled FROM users_table")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return applyFilter(out, f), nil
+}
+
+func (r *postgresRepo) Get(ctx context.Context, humanUser string) (User, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled FROM users_table WHERE human_user = $1",
+		humanUser)
+
+	u, err := scanUser(row)
+	if err == sql.ErrNoRows {
+		return User{}, ErrNotFound
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (r *postgresRepo) Create(ctx context.Context, input InputUser) error {
+	createDate, err := nullTimeFromDate(input.CreateDate)
+	if err != nil {
+		return err
+	}
+	passwordChangedDate, err := nullTimeFromDate(input.PasswordChangedDate)
+	if err != nil {
+		return err
+	}
+	lastAccessDate, err := nullTimeFromDate(input.LastAccessDate)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO users_table (id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled) VALUES ($1, $2, $3, $4, $5, $6)",
+		uuid.NewString(), input.HumanUser, createDate, passwordChangedDate, lastAccessDate, input.MFAEnabled)
+	return err
+}
+
+func (r *postgresRepo) Update(ctx context.Context, humanUser string, input InputUser) error {
+	createDate, err := nullTimeFromDate(input.CreateDate)
+	if err != nil {
+		return err
+	}
+	passwordChangedDate, err := nullTimeFromDate(input.PasswordChangedDate)
+	if err != nil {
+		return err
+	}
+	lastAccessDate, err := nullTimeFromDate(input.LastAccessDate)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE users_table SET create_date = $1, password_changed_date = $2, last_access_date = $3, mfa_enabled = $4 WHERE human_user = $5",
+		createDate, passwordChangedDate, lastAccessDate, input.MFAEnabled, humanUser)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *postgresRepo) Delete(ctx context.Context, humanUser string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM users_table WHERE human_user = $1", humanUser)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows, letting scanUser
+// back both Get and List.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row rowScanner) (User, error) {
+	var u User
+	var createDate, passwordChangedDate, lastAccessDate sql.NullTime
+	var mfaEnabled sql.NullBool
+
+	if err := row.Scan(&u.ID, &u.HumanUser, &createDate, &passwordChangedDate, &lastAccessDate, &mfaEnabled); err != nil {
+		return User{}, err
+	}
+
+	u.CreateDate = dateFromNullTime(createDate)
+	u.PasswordChangedDate = dateFromNullTime(passwordChangedDate)
+	u.LastAccessDate = dateFromNullTime(lastAccessDate)
+	u.MFAEnabled = mfaEnabled.Valid && mfaEnabled.Bool
+	return u, nil
+}
+
+// nullTimeFromDate parses an RFC3339 (or legacy "Jan 2 2006") date string
+// into a sql.NullTime suitable for a TIMESTAMPTZ column; an empty string
+// stores NULL.
+func nullTimeFromDate(s string) (sql.NullTime, error) {
+	if s == "" {
+		return sql.NullTime{}, nil
+	}
+	t, err := ParseDate(s)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	return sql.NullTime{Time: t, Valid: true}, nil
+}
+
+// dateFromNullTime renders a TIMESTAMPTZ column back into the repository's
+// canonical RFC3339 date string, or "" if it was NULL.
+func dateFromNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return FormatDate(t.Time)
+}
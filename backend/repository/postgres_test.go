@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func newMockPostgresRepo(t *testing.T) (UserRepository, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() { mockDB.Close() })
+	return NewPostgresRepository(mockDB), mock
+}
+
+func TestPostgresRepo_List(t *testing.T) {
+	repo, mock := newMockPostgresRepo(t)
+
+	rows := sqlmock.NewRows([]string{"id", "human_user", "create_date", "password_changed_date", "last_access_date", "mfa_enabled"}).
+		AddRow("11111111-1111-1111-1111-111111111111", "testuser1", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC), true).
+		AddRow("22222222-2222-2222-2222-222222222222", "testuser2", time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC), false)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled FROM users_table")).
+		WillReturnRows(rows)
+
+	users, err := repo.List(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+	if users[0].ID == "" || users[0].HumanUser != "testuser1" || !users[0].MFAEnabled {
+		t.Errorf("unexpected data for user1: %+v", users[0])
+	}
+	if users[1].ID == "" || users[1].HumanUser != "testuser2" || users[1].MFAEnabled {
+		t.Errorf("unexpected data for user2: %+v", users[1])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresRepo_Create(t *testing.T) {
+	repo, mock := newMockPostgresRepo(t)
+
+	input := InputUser{
+		HumanUser:           "newbie",
+		CreateDate:          "2025-05-18T00:00:00Z",
+		PasswordChangedDate: "2025-05-18T00:00:00Z",
+		LastAccessDate:      "2025-05-18T00:00:00Z",
+		MFAEnabled:          true,
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO users_table (id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled) VALUES ($1, $2, $3, $4, $5, $6)")).
+		WithArgs(sqlmock.AnyArg(), input.HumanUser, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), input.MFAEnabled).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := repo.Create(context.Background(), input); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresRepo_Create_LegacyDateFormat(t *testing.T) {
+	repo, mock := newMockPostgresRepo(t)
+
+	input := InputUser{HumanUser: "newbie", CreateDate: "May 18 2025"}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO users_table (id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled) VALUES ($1, $2, $3, $4, $5, $6)")).
+		WithArgs(sqlmock.AnyArg(), input.HumanUser, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), input.MFAEnabled).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := repo.Create(context.Background(), input); err != nil {
+		t.Fatalf("Create returned error for legacy date format: %v", err)
+	}
+}
+
+func TestPostgresRepo_GetNotFound(t *testing.T) {
+	repo, mock := newMockPostgresRepo(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, human_user, create_date, password_changed_date, last_access_date, mfa_enabled FROM users_table WHERE human_user = $1")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "human_user", "create_date", "password_changed_date", "last_access_date", "mfa_enabled"}))
+
+	_, err := repo.Get(context.Background(), "missing")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestPostgresRepo_DeleteNotFound(t *testing.T) {
+	repo, mock := newMockPostgresRepo(t)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM users_table WHERE human_user = $1")).
+		WithArgs("missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := repo.Delete(context.Background(), "missing"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+// Package repository defines the storage-agnostic interface strato's HTTP
+// handlers use to read and write users, plus the concrete stores that back
+// it (PostgreSQL and an in-memory map for tests and local dev).
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by Get/Update/Delete when no user matches the
+// given HumanUser.
+var ErrNotFound = errors.New("repository: user not found")
+
+// ErrAlreadyExists is returned by Create when a user with the same
+// HumanUser already exists.
+var ErrAlreadyExists = errors.New("repository: user already exists")
+
+// User is a user record as stored by a repository: the fields persisted to
+// the backing store, with no request-derived fields (e.g. days-since-*)
+// mixed in.
+type User struct {
+	// ID is the stable UUID primary key. It is assigned by the repository
+	// on Create and never changes; HumanUser remains the unique
+	// human-facing identifier callers look users up by.
+	ID                  string
+	HumanUser           string
+	CreateDate          string
+	PasswordChangedDate string
+	LastAccessDate      string
+	MFAEnabled          bool
+}
+
+// InputUser is the set of fields a caller supplies when creating or
+// replacing a user.
+type InputUser struct {
+	HumanUser           string
+	CreateDate          string
+	PasswordChangedDate string
+	LastAccessDate      string
+	MFAEnabled          bool
+}
+
+// FromInput copies an InputUser's fields into a User, leaving ID unset for
+// the caller (typically a UserRepository.Create implementation) to assign.
+func FromInput(input InputUser) User {
+	return User{
+		HumanUser:           input.HumanUser,
+		CreateDate:          input.CreateDate,
+		PasswordChangedDate: input.PasswordChangedDate,
+		LastAccessDate:      input.LastAccessDate,
+		MFAEnabled:          input.MFAEnabled,
+	}
+}
+
+// Filter narrows and orders the results returned by List.
+type Filter struct {
+	// Sort is the field to order by. "lastAccessDate" sorts by LastAccessDate;
+	// any other value (including "") falls back to HumanUser. Ties always
+	// break on HumanUser so pagination is stable.
+	Sort string
+	// Limit caps the number of users returned. 0 means no limit.
+	Limit int
+	// Cursor is the HumanUser of the last row returned by the previous page;
+	// List resumes just after it. "" starts from the beginning.
+	Cursor string
+}
+
+// UserRepository is the storage interface every user store implements.
+// Handlers depend on this interface rather than a concrete database so the
+// HTTP layer can be tested against an in-memory store without sqlmock.
+type UserRepository interface {
+	List(ctx context.Context, filter Filter) ([]User, error)
+	Get(ctx context.Context, humanUser string) (User, error)
+	Create(ctx context.Context, input InputUser) error
+	Update(ctx context.Context, humanUser string, input InputUser) error
+	Delete(ctx context.Context, humanUser string) error
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/devabhixda/strato/backend/auth"
+	"github.com/devabhixda/strato/backend/events"
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+// patchUserInput is the body accepted by PATCH /api/users/{humanUser}: only
+// mfaEnabled and lastAccessDate may be updated in place. Unknown fields are
+// rejected so typos (e.g. "mfaenabled") fail loudly instead of silently
+// no-oping.
+type patchUserInput struct {
+	MFAEnabled     *string `json:"mfaEnabled"`
+	LastAccessDate *string `json:"lastAccessDate"`
+}
+
+// userHandler serves GET/PUT/PATCH/DELETE /api/users/{humanUser}. Mutations
+// use strong ETags for optimistic concurrency: the caller must send the
+// ETag last read back as If-Match, or the request is rejected with 412.
+func (s *server) userHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, PUT, PATCH, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, If-Match, Authorization")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	humanUser := strings.TrimPrefix(r.URL.Path, "/api/users/")
+	if humanUser == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var scope string
+	switch r.Method {
+	case http.MethodGet:
+		scope = auth.ScopeUsersRead
+	case http.MethodPut, http.MethodPatch:
+		scope = auth.ScopeUsersWrite
+	case http.MethodDelete:
+		scope = auth.ScopeUsersAdmin
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	principal, reqID, ok := s.authenticate(w, r, scope)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getUser(w, r, humanUser, principal, reqID)
+	case http.MethodPut:
+		s.putUser(w, r, humanUser, principal, reqID)
+	case http.MethodPatch:
+		s.patchUser(w, r, humanUser, principal, reqID)
+	case http.MethodDelete:
+		s.deleteUser(w, r, humanUser, principal, reqID)
+	}
+}
+
+func (s *server) getUser(w http.ResponseWriter, r *http.Request, humanUser string, principal auth.Principal, reqID string) {
+	stored, err := s.repo.Get(r.Context(), humanUser)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to get user", http.StatusInternalServerError)
+		return
+	}
+
+	auth.Audit(reqID, principal, "read", humanUser)
+	w.Header().Set("ETag", etagFor(stored))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(withComputedFields(toAPIUser(stored), time.Now().UTC()))
+}
+
+// checkIfMatch fetches the current row and verifies the request's If-Match
+// header matches its ETag. It writes the appropriate error response and
+// returns ok=false if the header is missing, the user doesn't exist, or the
+// ETag doesn't match.
+func (s *server) checkIfMatch(w http.ResponseWriter, r *http.Request, humanUser string) (stored repository.User, ok bool) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		http.Error(w, "If-Match header is required", http.StatusBadRequest)
+		return repository.User{}, false
+	}
+
+	stored, err := s.repo.Get(r.Context(), humanUser)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return repository.User{}, false
+	}
+	if err != nil {
+		http.Error(w, "Failed to get user", http.StatusInternalServerError)
+		return repository.User{}, false
+	}
+
+	if ifMatch != etagFor(stored) {
+		http.Error(w, "ETag mismatch", http.StatusPreconditionFailed)
+		return repository.User{}, false
+	}
+
+	return stored, true
+}
+
+func (s *server) putUser(w http.ResponseWriter, r *http.Request, humanUser string, principal auth.Principal, reqID string) {
+	if _, ok := s.checkIfMatch(w, r, humanUser); !ok {
+		return
+	}
+
+	var body InputUser
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	body.HumanUser = humanUser
+
+	input, err := toRepoInput(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.repo.Update(r.Context(), humanUser, input); err != nil {
+		http.Error(w, "Failed to update user", http.StatusInternalServerError)
+		return
+	}
+
+	auth.Audit(reqID, principal, "update", humanUser)
+	s.events.Publish(events.Event{Type: events.Updated, User: repository.FromInput(input)})
+	s.getUser(w, r, humanUser, principal, reqID)
+}
+
+func (s *server) patchUser(w http.ResponseWriter, r *http.Request, humanUser string, principal auth.Principal, reqID string) {
+	stored, ok := s.checkIfMatch(w, r, humanUser)
+	if !ok {
+		return
+	}
+
+	var patch patchUserInput
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&patch); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if patch.MFAEnabled != nil {
+		switch strings.ToLower(*patch.MFAEnabled) {
+		case "yes":
+			stored.MFAEnabled = true
+		case "no":
+			stored.MFAEnabled = false
+		default:
+			http.Error(w, errInvalidMFAEnabled.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if patch.LastAccessDate != nil {
+		stored.LastAccessDate = *patch.LastAccessDate
+	}
+
+	input := repository.InputUser{
+		HumanUser:           stored.HumanUser,
+		CreateDate:          stored.CreateDate,
+		PasswordChangedDate: stored.PasswordChangedDate,
+		LastAccessDate:      stored.LastAccessDate,
+		MFAEnabled:          stored.MFAEnabled,
+	}
+	if err := s.repo.Update(r.Context(), humanUser, input); err != nil {
+		http.Error(w, "Failed to update user", http.StatusInternalServerError)
+		return
+	}
+
+	auth.Audit(reqID, principal, "update", humanUser)
+	s.events.Publish(events.Event{Type: events.Updated, User: repository.FromInput(input)})
+	s.getUser(w, r, humanUser, principal, reqID)
+}
+
+func (s *server) deleteUser(w http.ResponseWriter, r *http.Request, humanUser string, principal auth.Principal, reqID string) {
+	stored, ok := s.checkIfMatch(w, r, humanUser)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.Delete(r.Context(), humanUser); err != nil {
+		http.Error(w, "Failed to delete user", http.StatusInternalServerError)
+		return
+	}
+
+	auth.Audit(reqID, principal, "delete", humanUser)
+	s.events.Publish(events.Event{Type: events.Deleted, User: stored})
+	w.WriteHeader(http.StatusNoContent)
+}
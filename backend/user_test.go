@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devabhixda/strato/backend/repository"
+)
+
+func TestGetUser_Handler_Success(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "alice", MFAEnabled: true, LastAccessDate: "Jan 1 2025"})
+
+	req := httptest.NewRequest("GET", "/api/users/alice", nil)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+	if rr.Header().Get("ETag") == "" {
+		t.Error("expected an ETag header on GET")
+	}
+
+	var got User
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("could not unmarshal response: %v", err)
+	}
+	if got.HumanUser != "alice" {
+		t.Errorf("expected alice, got %+v", got)
+	}
+}
+
+func TestGetUser_Handler_NotFound(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("GET", "/api/users/ghost", nil)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rr.Code)
+	}
+}
+
+func currentETag(t *testing.T, s *server, humanUser string) string {
+	t.Helper()
+	req := httptest.NewRequest("GET", "/api/users/"+humanUser, nil)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("could not fetch %s to read its ETag: got %d", humanUser, rr.Code)
+	}
+	return rr.Header().Get("ETag")
+}
+
+func TestPutUser_Handler_Success(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "bob", MFAEnabled: false})
+	etag := currentETag(t, s, "bob")
+
+	body, _ := json.Marshal(InputUser{HumanUser: "bob", MFAEnabled: "Yes", LastAccessDate: "Jun 1 2026"})
+	req := httptest.NewRequest("PUT", "/api/users/bob", bytes.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	stored, err := s.repo.Get(req.Context(), "bob")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !stored.MFAEnabled || stored.LastAccessDate != "Jun 1 2026" {
+		t.Errorf("unexpected state after PUT: %+v", stored)
+	}
+}
+
+func TestPutUser_Handler_PreconditionFailed(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "bob"})
+
+	body, _ := json.Marshal(InputUser{HumanUser: "bob", MFAEnabled: "Yes"})
+	req := httptest.NewRequest("PUT", "/api/users/bob", bytes.NewReader(body))
+	req.Header.Set("If-Match", `"stale-etag"`)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412, got %d", rr.Code)
+	}
+}
+
+func TestPutUser_Handler_MissingIfMatch(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "bob"})
+
+	body, _ := json.Marshal(InputUser{HumanUser: "bob", MFAEnabled: "Yes"})
+	req := httptest.NewRequest("PUT", "/api/users/bob", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 when If-Match is missing, got %d", rr.Code)
+	}
+}
+
+func TestPutUser_Handler_NotFound(t *testing.T) {
+	s := newTestServer()
+
+	body, _ := json.Marshal(InputUser{HumanUser: "ghost", MFAEnabled: "Yes"})
+	req := httptest.NewRequest("PUT", "/api/users/ghost", bytes.NewReader(body))
+	req.Header.Set("If-Match", `"whatever"`)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rr.Code)
+	}
+}
+
+func TestPatchUser_Handler_PartialUpdate(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "carol", MFAEnabled: false, LastAccessDate: "Jan 1 2020", CreateDate: "Jan 1 2019"})
+	etag := currentETag(t, s, "carol")
+
+	body, _ := json.Marshal(map[string]string{"mfaEnabled": "Yes"})
+	req := httptest.NewRequest("PATCH", "/api/users/carol", bytes.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	stored, _ := s.repo.Get(req.Context(), "carol")
+	if !stored.MFAEnabled {
+		t.Errorf("expected MFAEnabled true, got %+v", stored)
+	}
+	if stored.CreateDate != "Jan 1 2019" {
+		t.Errorf("expected untouched fields to survive a partial update, got %+v", stored)
+	}
+}
+
+func TestPatchUser_Handler_RejectsUnknownField(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "carol"})
+	etag := currentETag(t, s, "carol")
+
+	body, _ := json.Marshal(map[string]string{"humanUser": "mallory"})
+	req := httptest.NewRequest("PATCH", "/api/users/carol", bytes.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unsupported patch field, got %d", rr.Code)
+	}
+}
+
+func TestPatchUser_Handler_InvalidMFAValue(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "carol"})
+	etag := currentETag(t, s, "carol")
+
+	body, _ := json.Marshal(map[string]string{"mfaEnabled": "maybe"})
+	req := httptest.NewRequest("PATCH", "/api/users/carol", bytes.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid mfaEnabled value, got %d", rr.Code)
+	}
+}
+
+func TestDeleteUser_Handler_Success(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "dave"})
+	etag := currentETag(t, s, "dave")
+
+	req := httptest.NewRequest("DELETE", "/api/users/dave", nil)
+	req.Header.Set("If-Match", etag)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rr.Code)
+	}
+
+	if _, err := s.repo.Get(req.Context(), "dave"); err != repository.ErrNotFound {
+		t.Errorf("expected user to be deleted, got err=%v", err)
+	}
+}
+
+func TestDeleteUser_Handler_NotFound(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("DELETE", "/api/users/ghost", nil)
+	req.Header.Set("If-Match", `"whatever"`)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rr.Code)
+	}
+}
+
+func TestDeleteUser_Handler_PreconditionFailed(t *testing.T) {
+	s := newTestServer(repository.User{HumanUser: "dave"})
+
+	req := httptest.NewRequest("DELETE", "/api/users/dave", nil)
+	req.Header.Set("If-Match", `"stale-etag"`)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412, got %d", rr.Code)
+	}
+}
+
+func TestUserHandler_OptionsMethod(t *testing.T) {
+	s := newTestServer()
+
+	req := httptest.NewRequest("OPTIONS", "/api/users/alice", nil)
+	rr := httptest.NewRecorder()
+	s.userHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected 200 for OPTIONS, got %d", rr.Code)
+	}
+}
+
+func TestGetUsers_Handler_Pagination(t *testing.T) {
+	s := newTestServer(
+		repository.User{HumanUser: "alice"},
+		repository.User{HumanUser: "bob"},
+		repository.User{HumanUser: "carol"},
+	)
+
+	req := httptest.NewRequest("GET", "/api/users?limit=2", nil)
+	rr := httptest.NewRecorder()
+	s.usersHandler(rr, req)
+
+	var page1 []User
+	json.Unmarshal(rr.Body.Bytes(), &page1)
+	if len(page1) != 2 || page1[0].HumanUser != "alice" || page1[1].HumanUser != "bob" {
+		t.Fatalf("unexpected first page: %+v", page1)
+	}
+
+	req2 := httptest.NewRequest("GET", "/api/users?limit=2&cursor=bob", nil)
+	rr2 := httptest.NewRecorder()
+	s.usersHandler(rr2, req2)
+
+	var page2 []User
+	json.Unmarshal(rr2.Body.Bytes(), &page2)
+	if len(page2) != 1 || page2[0].HumanUser != "carol" {
+		t.Fatalf("unexpected second page: %+v", page2)
+	}
+}